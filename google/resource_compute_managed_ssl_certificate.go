@@ -0,0 +1,246 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeManagedSslCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeManagedSslCertificateCreate,
+		Read:   resourceComputeManagedSslCertificateRead,
+		Delete: resourceComputeManagedSslCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"managed": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domains": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"domain_status": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"subject_alternative_names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_timestamp": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeManagedSslCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	domainsRaw := d.Get("managed.0.domains").([]interface{})
+	domains := make([]string, 0, len(domainsRaw))
+	for _, domain := range domainsRaw {
+		domains = append(domains, domain.(string))
+	}
+
+	cert := &compute.SslCertificate{
+		Name: d.Get("name").(string),
+		Type: "MANAGED",
+		Managed: &compute.SslCertificateManagedSslCertificate{
+			Domains: domains,
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		cert.Description = v.(string)
+	}
+
+	log.Printf("[DEBUG] Managed SslCertificate insert request: %#v", cert)
+	op, err := config.clientCompute.SslCertificates.Insert(project, cert).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating Managed SslCertificate: %s", err)
+	}
+
+	err = computeOperationWait(config.clientCompute, op, project, "Creating Managed SSL Certificate")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(cert.Name)
+
+	if err := waitForManagedSslCertificateActive(config, project, cert.Name, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceComputeManagedSslCertificateRead(d, meta)
+}
+
+// waitForManagedSslCertificateActive polls the certificate until Google
+// finishes domain validation and provisioning (it leaves PROVISIONING),
+// since this status lives on the resource itself rather than on the
+// long-running operation returned by Insert.
+func waitForManagedSslCertificateActive(config *Config, project, name string, timeout time.Duration) error {
+	conf := &resource.StateChangeConf{
+		Pending: []string{"PROVISIONING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			cert, err := config.clientCompute.SslCertificates.Get(project, name).Do()
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := "PROVISIONING"
+			if cert.Managed != nil && cert.Managed.Status != "" {
+				status = cert.Managed.Status
+			}
+
+			if status != "PROVISIONING" && status != "ACTIVE" {
+				domainStatus := ""
+				if cert.Managed != nil {
+					for domain, s := range cert.Managed.DomainStatus {
+						domainStatus += fmt.Sprintf(" %s=%s", domain, s)
+					}
+				}
+				return cert, "", fmt.Errorf("managed SSL certificate %q did not become active (status %s):%s", name, status, domainStatus)
+			}
+
+			return cert, status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := conf.WaitForState()
+	return err
+}
+
+func resourceComputeManagedSslCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	cert, err := config.clientCompute.SslCertificates.Get(project, d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Managed SslCertificate %q", d.Get("name").(string)))
+	}
+
+	d.Set("name", cert.Name)
+	d.Set("description", cert.Description)
+	d.Set("subject_alternative_names", cert.SubjectAlternativeNames)
+	d.Set("expire_time", cert.ExpireTime)
+	d.Set("self_link", cert.SelfLink)
+	d.Set("certificate_id", strconv.FormatUint(cert.Id, 10))
+	d.Set("creation_timestamp", cert.CreationTimestamp)
+	d.Set("project", project)
+
+	if cert.Managed != nil {
+		d.Set("managed", []map[string]interface{}{
+			{
+				"domains":       cert.Managed.Domains,
+				"status":        cert.Managed.Status,
+				"domain_status": cert.Managed.DomainStatus,
+			},
+		})
+	}
+
+	return nil
+}
+
+func resourceComputeManagedSslCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Managed SslCertificate delete request")
+	op, err := config.clientCompute.SslCertificates.Delete(project, d.Id()).Do()
+	if err != nil {
+		return fmt.Errorf("Error deleting Managed SslCertificate: %s", err)
+	}
+
+	err = computeOperationWait(config.clientCompute, op, project, "Deleting Managed SSL Certificate")
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,9 @@
+package google
+
+import "testing"
+
+func TestResourceComputeManagedSslCertificate_schemaIsValid(t *testing.T) {
+	if err := resourceComputeManagedSslCertificate().InternalValidate(nil, true); err != nil {
+		t.Fatalf("resourceComputeManagedSslCertificate schema is invalid: %s", err)
+	}
+}
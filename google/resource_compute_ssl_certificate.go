@@ -0,0 +1,176 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeSslCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeSslCertificateCreate,
+		Read:   resourceComputeSslCertificateRead,
+		Delete: resourceComputeSslCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+			},
+
+			"name_prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"certificate": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"private_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_timestamp": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeSslCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.PrefixedUniqueId(d.Get("name_prefix").(string))
+	}
+
+	cert := &compute.SslCertificate{
+		Name:        name,
+		Certificate: d.Get("certificate").(string),
+		PrivateKey:  d.Get("private_key").(string),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		cert.Description = v.(string)
+	}
+
+	log.Printf("[DEBUG] SslCertificate insert request: %#v", cert)
+	op, err := config.clientCompute.SslCertificates.Insert(project, cert).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating SslCertificate: %s", err)
+	}
+
+	err = computeOperationWait(config.clientCompute, op, project, "Creating SSL Certificate")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(cert.Name)
+
+	return resourceComputeSslCertificateRead(d, meta)
+}
+
+func resourceComputeSslCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	cert, err := config.clientCompute.SslCertificates.Get(project, d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SslCertificate %q", d.Get("name").(string)))
+	}
+
+	d.Set("name", cert.Name)
+	d.Set("certificate", cert.Certificate)
+	d.Set("description", cert.Description)
+	d.Set("self_link", cert.SelfLink)
+	d.Set("certificate_id", strconv.FormatUint(cert.Id, 10))
+	d.Set("creation_timestamp", cert.CreationTimestamp)
+	d.Set("project", project)
+
+	return nil
+}
+
+func resourceComputeSslCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	inUseBy, err := sslCertificateInUseByProxy(config, project, d.Id())
+	if err != nil {
+		return err
+	}
+	if inUseBy != "" {
+		return fmt.Errorf("Cannot delete SSL certificate %q: still referenced by %s - if this proxy has certificate_rotation configured, wait for min_overlap to elapse and re-apply so it prunes the cert first", d.Get("name").(string), inUseBy)
+	}
+
+	log.Printf("[DEBUG] SslCertificate delete request")
+	op, err := config.clientCompute.SslCertificates.Delete(project, d.Id()).Do()
+	if err != nil {
+		return fmt.Errorf("Error deleting SslCertificate: %s", err)
+	}
+
+	err = computeOperationWait(config.clientCompute, op, project, "Deleting SSL Certificate")
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/compute/v1"
 )
 
@@ -21,6 +22,8 @@ func resourceComputeTargetHttpsProxy() *schema.Resource {
 		Delete: resourceComputeTargetHttpsProxyDelete,
 		Update: resourceComputeTargetHttpsProxyUpdate,
 
+		CustomizeDiff: sslCertRotationCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -53,6 +56,15 @@ func resourceComputeTargetHttpsProxy() *schema.Resource {
 				DiffSuppressFunc: compareSelfLinkRelativePaths,
 			},
 
+			"quic_override": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "ENABLE", "DISABLE"}, false),
+			},
+
+			"certificate_rotation": sslCertRotationSchema(),
+
 			"description": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -96,6 +108,7 @@ func resourceComputeTargetHttpsProxyCreate(d *schema.ResourceData, meta interfac
 		Name:            d.Get("name").(string),
 		UrlMap:          d.Get("url_map").(string),
 		SslCertificates: sslCertificates,
+		QuicOverride:    d.Get("quic_override").(string),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -161,26 +174,28 @@ func resourceComputeTargetHttpsProxyUpdate(d *schema.ResourceData, meta interfac
 		d.SetPartial("url_map")
 	}
 
-	if d.HasChange("ssl_certificates") {
-		certs, err := expandSslCertificates(d, config)
-		if err != nil {
-			return err
-		}
-		cert_ref := &compute.TargetHttpsProxiesSetSslCertificatesRequest{
-			SslCertificates: certs,
-		}
-		op, err := config.clientCompute.TargetHttpsProxies.SetSslCertificates(
-			project, d.Id(), cert_ref).Do()
-		if err != nil {
-			return fmt.Errorf("Error updating Target Https Proxy SSL Certificates: %s", err)
+	if d.HasChange("ssl_certificates") || d.HasChange("certificate_rotation") {
+		rotator := &sslCertRotator{
+			d:       d,
+			config:  config,
+			project: project,
+			setCerts: func(certs []string) error {
+				op, err := config.clientCompute.TargetHttpsProxies.SetSslCertificates(
+					project, d.Id(), &compute.TargetHttpsProxiesSetSslCertificatesRequest{
+						SslCertificates: certs,
+					}).Do()
+				if err != nil {
+					return fmt.Errorf("Error updating Target Https Proxy SSL Certificates: %s", err)
+				}
+				return computeOperationWait(config.clientCompute, op, project, "Updating Target Https Proxy SSL certificates")
+			},
 		}
-
-		err = computeOperationWait(config.clientCompute, op, project, "Updating Target Https Proxy SSL certificates")
-		if err != nil {
+		if err := rotator.rotate(); err != nil {
 			return err
 		}
 
-		d.SetPartial("ssl_certificate")
+		d.SetPartial("ssl_certificates")
+		d.SetPartial("certificate_rotation")
 	}
 
 	if d.HasChange("ssl_policy") {
@@ -201,6 +216,22 @@ func resourceComputeTargetHttpsProxyUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if d.HasChange("quic_override") {
+		op, err := config.clientCompute.TargetHttpsProxies.SetQuicOverride(
+			project, d.Id(), &compute.TargetHttpsProxiesSetQuicOverrideRequest{
+				QuicOverride: d.Get("quic_override").(string),
+			}).Do()
+		if err != nil {
+			return errwrap.Wrapf("Error updating Target HTTPS Proxy QUIC override: {{err}}", err)
+		}
+		waitErr := computeSharedOperationWait(config.clientCompute, op, project, "Updating Target HTTPS Proxy QUIC override")
+		if waitErr != nil {
+			return waitErr
+		}
+
+		d.SetPartial("quic_override")
+	}
+
 	d.Partial(false)
 
 	return resourceComputeTargetHttpsProxyRead(d, meta)
@@ -220,7 +251,7 @@ func resourceComputeTargetHttpsProxyRead(d *schema.ResourceData, meta interface{
 		return handleNotFoundError(err, d, fmt.Sprintf("Target HTTPS proxy %q", d.Get("name").(string)))
 	}
 
-	d.Set("ssl_certificates", proxy.SslCertificates)
+	d.Set("ssl_certificates", reconcileRotatedSslCertificates(d, proxy.SslCertificates))
 	d.Set("proxy_id", strconv.FormatUint(proxy.Id, 10))
 	d.Set("self_link", proxy.SelfLink)
 	d.Set("description", proxy.Description)
@@ -228,6 +259,7 @@ func resourceComputeTargetHttpsProxyRead(d *schema.ResourceData, meta interface{
 	d.Set("name", proxy.Name)
 	d.Set("project", project)
 	d.Set("ssl_policy", proxy.SslPolicy)
+	d.Set("quic_override", proxy.QuicOverride)
 
 	return nil
 }
@@ -257,6 +289,11 @@ func resourceComputeTargetHttpsProxyDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// expandSslCertificates resolves the configured ssl_certificates references
+// into relative links. Since both google_compute_ssl_certificate and
+// google_compute_managed_ssl_certificate resources live in the same global
+// sslCertificates collection, ParseSslCertificateFieldValue resolves
+// references to either type with no changes needed here.
 func expandSslCertificates(d *schema.ResourceData, config *Config) ([]string, error) {
 	configured := d.Get("ssl_certificates").([]interface{})
 	certs := make([]string, 0, len(configured))
@@ -16,6 +16,8 @@ func resourceComputeTargetSslProxy() *schema.Resource {
 		Delete: resourceComputeTargetSslProxyDelete,
 		Update: resourceComputeTargetSslProxyUpdate,
 
+		CustomizeDiff: sslCertRotationCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -60,6 +62,8 @@ func resourceComputeTargetSslProxy() *schema.Resource {
 				DiffSuppressFunc: compareSelfLinkRelativePaths,
 			},
 
+			"certificate_rotation": sslCertRotationSchema(),
+
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -179,26 +183,27 @@ func resourceComputeTargetSslProxyUpdate(d *schema.ResourceData, meta interface{
 		d.SetPartial("backend_service")
 	}
 
-	if d.HasChange("ssl_certificates") {
-		sslCertificates, err := expandSslCertificates(d, config)
-		if err != nil {
-			return err
-		}
-
-		op, err := config.clientCompute.TargetSslProxies.SetSslCertificates(project, d.Id(), &compute.TargetSslProxiesSetSslCertificatesRequest{
-			SslCertificates: sslCertificates,
-		}).Do()
-
-		if err != nil {
-			return fmt.Errorf("Error updating backend_service: %s", err)
+	if d.HasChange("ssl_certificates") || d.HasChange("certificate_rotation") {
+		rotator := &sslCertRotator{
+			d:       d,
+			config:  config,
+			project: project,
+			setCerts: func(certs []string) error {
+				op, err := config.clientCompute.TargetSslProxies.SetSslCertificates(project, d.Id(), &compute.TargetSslProxiesSetSslCertificatesRequest{
+					SslCertificates: certs,
+				}).Do()
+				if err != nil {
+					return fmt.Errorf("Error updating Target SSL Proxy SSL Certificates: %s", err)
+				}
+				return computeOperationWait(config.clientCompute, op, project, "Updating Target SSL Proxy")
+			},
 		}
-
-		err = computeOperationWait(config.clientCompute, op, project, "Updating Target SSL Proxy")
-		if err != nil {
+		if err := rotator.rotate(); err != nil {
 			return err
 		}
 
 		d.SetPartial("ssl_certificates")
+		d.SetPartial("certificate_rotation")
 	}
 
 	if d.HasChange("ssl_policy") {
@@ -242,7 +247,7 @@ func resourceComputeTargetSslProxyRead(d *schema.ResourceData, meta interface{})
 	d.Set("description", proxy.Description)
 	d.Set("proxy_header", proxy.ProxyHeader)
 	d.Set("backend_service", proxy.Service)
-	d.Set("ssl_certificates", proxy.SslCertificates)
+	d.Set("ssl_certificates", reconcileRotatedSslCertificates(d, proxy.SslCertificates))
 	d.Set("ssl_policy", proxy.SslPolicy)
 	d.Set("project", project)
 	d.Set("self_link", proxy.SelfLink)
@@ -0,0 +1,353 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// sslCertRotationSchema returns the shared `certificate_rotation` block used
+// by google_compute_target_https_proxy and google_compute_target_ssl_proxy to
+// opt into zero-downtime certificate swaps. Both resources embed this schema
+// verbatim so rotation semantics stay identical between the two proxy types.
+func sslCertRotationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"strategy": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "append_swap_prune",
+					ValidateFunc: validation.StringInSlice([]string{"append_swap_prune"}, false),
+				},
+
+				"min_overlap": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "24h",
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						if _, err := time.ParseDuration(v.(string)); err != nil {
+							errors = append(errors, fmt.Errorf("%q is not a valid duration: %s", k, err))
+						}
+						return
+					},
+				},
+
+				// rotated_at/pending_prune are provider bookkeeping, not
+				// anything the compute API exposes: once a rotation starts,
+				// they're the only record of which certs are being kept
+				// alive for the overlap window and when that window opened.
+				// They're never read back from the API in Read, only ever
+				// written wholesale by rotate() below, so they persist
+				// across the append-union apply and the later prune apply.
+				"rotated_at": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"pending_prune": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// sslCertRotationCustomizeDiff forces a diff on `certificate_rotation` once a
+// pending prune's overlap window has elapsed, even though nothing in the
+// user's config changed. Without this, a stable config converges to no diff
+// at all and Update - where the prune actually happens - would never be
+// invoked again.
+func sslCertRotationCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	rotationRaw, ok := diff.GetOk("certificate_rotation")
+	if !ok {
+		return nil
+	}
+
+	rotation := rotationRaw.([]interface{})[0].(map[string]interface{})
+	if len(rotation["pending_prune"].([]interface{})) == 0 {
+		return nil
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, rotation["rotated_at"].(string))
+	if err != nil {
+		return nil
+	}
+
+	minOverlap, err := time.ParseDuration(rotation["min_overlap"].(string))
+	if err != nil {
+		return nil
+	}
+
+	if time.Since(rotatedAt) < minOverlap {
+		return nil
+	}
+
+	return diff.SetNewComputed("certificate_rotation")
+}
+
+// sslCertRotator drives the "append new, swap, prune old" update sequence
+// shared by the target proxy resources. Callers supply a setCerts closure
+// that performs the actual TargetHttpsProxies/TargetSslProxies
+// SetSslCertificates call, since the request payload type differs between
+// the two APIs.
+type sslCertRotator struct {
+	d       *schema.ResourceData
+	config  *Config
+	project string
+
+	// setCerts applies the given (already-expanded) certificate relative
+	// links to the proxy and waits for the operation to finish.
+	setCerts func(certs []string) error
+}
+
+// rotate implements the update-time logic for the `ssl_certificates` field.
+// When no `certificate_rotation` block is configured it falls back to the
+// plain swap the proxies have always done. When rotation is enabled, a
+// change to `ssl_certificates` first applies the union of the old and new
+// certs (so the old cert stays bound until the new one is live) and records
+// which certs are still pending prune; those are only removed once
+// `min_overlap` has elapsed, which sslCertRotationCustomizeDiff detects and
+// surfaces as a later, separate apply (or immediately, for `min_overlap =
+// "0s"`).
+//
+// Read (in both target proxy resources) reconciles the live, possibly-union
+// `ssl_certificates` back down to the desired set by subtracting
+// pending_prune, so a stable config converges to an empty plan instead of
+// re-triggering the append branch on every subsequent apply.
+func (r *sslCertRotator) rotate() error {
+	rotationRaw, ok := r.d.GetOk("certificate_rotation")
+	if !ok {
+		if r.d.HasChange("ssl_certificates") {
+			certs, err := expandSslCertificates(r.d, r.config)
+			if err != nil {
+				return err
+			}
+			return r.setCerts(certs)
+		}
+		return nil
+	}
+
+	rotation := rotationRaw.([]interface{})[0].(map[string]interface{})
+	minOverlap, err := time.ParseDuration(rotation["min_overlap"].(string))
+	if err != nil {
+		return fmt.Errorf("Invalid certificate_rotation.min_overlap: %s", err)
+	}
+
+	if r.d.HasChange("ssl_certificates") {
+		oldRaw, newRaw := r.d.GetChange("ssl_certificates")
+		oldCerts, err := expandSslCertificateRefs(r.d, r.config, oldRaw.([]interface{}))
+		if err != nil {
+			return err
+		}
+		newCerts, err := expandSslCertificateRefs(r.d, r.config, newRaw.([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		if err := r.setCerts(sslCertUnion(oldCerts, newCerts)); err != nil {
+			return err
+		}
+
+		removed := sslCertDifference(oldCerts, newCerts)
+		if len(removed) == 0 {
+			return r.setRotationState(rotation, "", nil)
+		}
+
+		if minOverlap <= 0 {
+			// No overlap requested: prune in the same apply instead of
+			// waiting for a CustomizeDiff-forced follow-up.
+			if err := r.setCerts(newCerts); err != nil {
+				return err
+			}
+			return r.setRotationState(rotation, "", nil)
+		}
+
+		// pending_prune is diffed against proxy.SslCertificates in Read,
+		// which the compute API always returns as full self-link URLs, not
+		// the relative links expandSslCertificateRefs produces - so it has
+		// to be recorded in that same canonical form or the prune/convergence
+		// check in reconcileRotatedSslCertificates never matches.
+		return r.setRotationState(rotation, time.Now().UTC().Format(time.RFC3339), r.toCanonicalLinks(removed))
+	}
+
+	// ssl_certificates didn't change this apply, so certificate_rotation
+	// did: either sslCertRotationCustomizeDiff forced a diff because the
+	// overlap window elapsed, or the block was edited in config. Prune only
+	// once the window has elapsed; before that the pending state is kept.
+	pendingPrune := rotation["pending_prune"].([]interface{})
+	if len(pendingPrune) == 0 {
+		return nil
+	}
+
+	rotatedAt := rotation["rotated_at"].(string)
+	if t, err := time.Parse(time.RFC3339, rotatedAt); err == nil && time.Since(t) < minOverlap {
+		kept := make([]string, 0, len(pendingPrune))
+		for _, cert := range pendingPrune {
+			kept = append(kept, cert.(string))
+		}
+		return r.setRotationState(rotation, rotatedAt, kept)
+	}
+
+	finalCerts, err := expandSslCertificates(r.d, r.config)
+	if err != nil {
+		return err
+	}
+
+	if err := r.setCerts(finalCerts); err != nil {
+		return err
+	}
+
+	return r.setRotationState(rotation, "", nil)
+}
+
+// setRotationState writes the whole `certificate_rotation` block back to
+// state in one call. The legacy helper/schema SDK does not reliably persist
+// a dotted sub-field address like `certificate_rotation.0.rotated_at` set on
+// its own - the block's own bookkeeping isn't updated - so rotated_at and
+// pending_prune must always be written together with the user-configured
+// strategy/min_overlap as a single list element.
+func (r *sslCertRotator) setRotationState(rotation map[string]interface{}, rotatedAt string, pendingPrune []string) error {
+	if pendingPrune == nil {
+		pendingPrune = []string{}
+	}
+	return r.d.Set("certificate_rotation", []interface{}{
+		map[string]interface{}{
+			"strategy":      rotation["strategy"],
+			"min_overlap":   rotation["min_overlap"],
+			"rotated_at":    rotatedAt,
+			"pending_prune": pendingPrune,
+		},
+	})
+}
+
+// expandSslCertificateRefs resolves a raw `ssl_certificates` list (as
+// returned by d.GetChange, which is not run through d.Get's normal
+// accessors) into the relative links the compute API expects.
+func expandSslCertificateRefs(d *schema.ResourceData, config *Config, raw []interface{}) ([]string, error) {
+	certs := make([]string, 0, len(raw))
+	for _, sslCertificate := range raw {
+		sslCertificateFieldValue, err := ParseSslCertificateFieldValue(sslCertificate.(string), d, config)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ssl certificate: %s", err)
+		}
+		certs = append(certs, sslCertificateFieldValue.RelativeLink())
+	}
+	return certs, nil
+}
+
+// toCanonicalLinks converts relative ssl certificate links (as produced by
+// expandSslCertificateRefs) into the full self-link URLs the compute API
+// returns from Get/List, e.g. "projects/p/global/sslCertificates/foo" ->
+// "https://www.googleapis.com/compute/v1/projects/p/global/sslCertificates/foo".
+func (r *sslCertRotator) toCanonicalLinks(relativeLinks []string) []string {
+	canonical := make([]string, 0, len(relativeLinks))
+	for _, link := range relativeLinks {
+		canonical = append(canonical, fmt.Sprintf(canonicalSslCertificateTemplate, r.project, certNameFromLink(link)))
+	}
+	return canonical
+}
+
+// certNameFromLink returns the final path segment of a relative or full ssl
+// certificate link.
+func certNameFromLink(link string) string {
+	if idx := strings.LastIndex(link, "/"); idx >= 0 {
+		return link[idx+1:]
+	}
+	return link
+}
+
+// sslCertUnion returns the deduplicated union of a and b, preserving a's
+// ordering followed by any new entries from b.
+func sslCertUnion(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	union := make([]string, 0, len(a)+len(b))
+	for _, certs := range [][]string{a, b} {
+		for _, cert := range certs {
+			if !seen[cert] {
+				seen[cert] = true
+				union = append(union, cert)
+			}
+		}
+	}
+	return union
+}
+
+// sslCertDifference returns the certs present in a but not in b.
+func sslCertDifference(a, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, cert := range b {
+		inB[cert] = true
+	}
+	diff := make([]string, 0)
+	for _, cert := range a {
+		if !inB[cert] {
+			diff = append(diff, cert)
+		}
+	}
+	return diff
+}
+
+// reconcileRotatedSslCertificates subtracts a proxy's recorded pending_prune
+// certs from its actual, live SslCertificates list, so Read reports the
+// desired steady-state set (matching config) rather than the transient
+// append-union superset that's genuinely bound to the proxy during the
+// overlap window.
+func reconcileRotatedSslCertificates(d *schema.ResourceData, actual []string) []string {
+	pendingPruneRaw, ok := d.GetOk("certificate_rotation.0.pending_prune")
+	if !ok {
+		return actual
+	}
+
+	pendingPrune := make([]string, 0)
+	for _, cert := range pendingPruneRaw.([]interface{}) {
+		pendingPrune = append(pendingPrune, cert.(string))
+	}
+
+	return sslCertDifference(actual, pendingPrune)
+}
+
+// sslCertificateInUseByProxy reports whether the given SSL certificate is
+// still bound to any target HTTPS or SSL proxy in the project - including
+// one mid certificate_rotation overlap window, since during that window the
+// proxy's actual, live SslCertificates list still contains it even though
+// it may have already been dropped from the proxy's Terraform config.
+// google_compute_ssl_certificate's delete path uses this to refuse deletion
+// rather than letting Terraform destroy a cert a live proxy depends on.
+func sslCertificateInUseByProxy(config *Config, project, certName string) (string, error) {
+	certLink := fmt.Sprintf(canonicalSslCertificateTemplate, project, certName)
+
+	httpsProxies, err := config.clientCompute.TargetHttpsProxies.List(project).Do()
+	if err != nil {
+		return "", fmt.Errorf("Error listing Target HTTPS Proxies to check SSL certificate references: %s", err)
+	}
+	for _, proxy := range httpsProxies.Items {
+		for _, cert := range proxy.SslCertificates {
+			if cert == certLink {
+				return fmt.Sprintf("target HTTPS proxy %q", proxy.Name), nil
+			}
+		}
+	}
+
+	sslProxies, err := config.clientCompute.TargetSslProxies.List(project).Do()
+	if err != nil {
+		return "", fmt.Errorf("Error listing Target SSL Proxies to check SSL certificate references: %s", err)
+	}
+	for _, proxy := range sslProxies.Items {
+		for _, cert := range proxy.SslCertificates {
+			if cert == certLink {
+				return fmt.Sprintf("target SSL proxy %q", proxy.Name), nil
+			}
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,127 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestSslCertUnion(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     []string
+		expected []string
+	}{
+		{
+			name:     "dedups and appends new entries from b after a",
+			a:        []string{"projects/p/global/sslCertificates/a", "projects/p/global/sslCertificates/b"},
+			b:        []string{"projects/p/global/sslCertificates/b", "projects/p/global/sslCertificates/c"},
+			expected: []string{"projects/p/global/sslCertificates/a", "projects/p/global/sslCertificates/b", "projects/p/global/sslCertificates/c"},
+		},
+		{
+			name:     "empty a",
+			a:        []string{},
+			b:        []string{"projects/p/global/sslCertificates/a"},
+			expected: []string{"projects/p/global/sslCertificates/a"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := sslCertUnion(c.a, c.b)
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("sslCertUnion(%v, %v) = %v, want %v", c.a, c.b, got, c.expected)
+			}
+		})
+	}
+}
+
+func TestSslCertDifference(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     []string
+		expected []string
+	}{
+		{
+			name:     "removes entries present in b",
+			a:        []string{"projects/p/global/sslCertificates/a", "projects/p/global/sslCertificates/b"},
+			b:        []string{"projects/p/global/sslCertificates/b"},
+			expected: []string{"projects/p/global/sslCertificates/a"},
+		},
+		{
+			name:     "nothing removed",
+			a:        []string{"projects/p/global/sslCertificates/a"},
+			b:        []string{"projects/p/global/sslCertificates/z"},
+			expected: []string{"projects/p/global/sslCertificates/a"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := sslCertDifference(c.a, c.b)
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("sslCertDifference(%v, %v) = %v, want %v", c.a, c.b, got, c.expected)
+			}
+		})
+	}
+}
+
+func TestSslCertRotatorToCanonicalLinks(t *testing.T) {
+	r := &sslCertRotator{project: "my-project"}
+
+	got := r.toCanonicalLinks([]string{"projects/my-project/global/sslCertificates/a"})
+	want := []string{"https://www.googleapis.com/compute/v1/projects/my-project/global/sslCertificates/a"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toCanonicalLinks() = %v, want %v", got, want)
+	}
+}
+
+// TestReconcileRotatedSslCertificates_fullLinkActualVsCanonicalPendingPrune
+// guards the bug where `actual` (always a full self-link URL, as returned by
+// the compute API) was diffed against a relative-link pending_prune,
+// silently never subtracting anything and leaving a permanent diff.
+func TestReconcileRotatedSslCertificates_fullLinkActualVsCanonicalPendingPrune(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"certificate_rotation": sslCertRotationSchema(),
+	}
+
+	raw := map[string]interface{}{
+		"certificate_rotation": []interface{}{
+			map[string]interface{}{
+				"strategy":    "append_swap_prune",
+				"min_overlap": "24h",
+				"rotated_at":  "2020-01-01T00:00:00Z",
+				"pending_prune": []interface{}{
+					"https://www.googleapis.com/compute/v1/projects/my-project/global/sslCertificates/old-cert",
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, s, raw)
+
+	actual := []string{
+		"https://www.googleapis.com/compute/v1/projects/my-project/global/sslCertificates/old-cert",
+		"https://www.googleapis.com/compute/v1/projects/my-project/global/sslCertificates/new-cert",
+	}
+
+	got := reconcileRotatedSslCertificates(d, actual)
+	want := []string{"https://www.googleapis.com/compute/v1/projects/my-project/global/sslCertificates/new-cert"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("reconcileRotatedSslCertificates() = %v, want %v", got, want)
+	}
+}
+
+func TestReconcileRotatedSslCertificates_noPendingPrune(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"certificate_rotation": sslCertRotationSchema(),
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+
+	actual := []string{"https://www.googleapis.com/compute/v1/projects/my-project/global/sslCertificates/a"}
+	got := reconcileRotatedSslCertificates(d, actual)
+	if !reflect.DeepEqual(got, actual) {
+		t.Errorf("reconcileRotatedSslCertificates() = %v, want %v (unchanged)", got, actual)
+	}
+}